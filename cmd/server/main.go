@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/cors"
+	"github.com/gofiber/fiber/v3/middleware/logger"
+	"github.com/gofiber/fiber/v3/middleware/recover"
+	"github.com/gofiber/fiber/v3/middleware/requestid"
+	_ "github.com/lib/pq"  // registers the "postgres" database/sql driver
+	_ "modernc.org/sqlite" // registers the "sqlite" database/sql driver
+
+	"github.com/rekasa7000/Logcha/internal/config"
+	"github.com/rekasa7000/Logcha/internal/handlers"
+	"github.com/rekasa7000/Logcha/internal/handlers/auth"
+	"github.com/rekasa7000/Logcha/internal/handlers/user"
+	"github.com/rekasa7000/Logcha/internal/middleware"
+	"github.com/rekasa7000/Logcha/internal/repository"
+	"github.com/rekasa7000/Logcha/internal/router"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// noopCloser satisfies io.Closer for the in-memory repository, which
+// holds no connection to release on shutdown.
+type noopCloser struct{}
+
+func (noopCloser) Close() error { return nil }
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	userRepository, closer, err := newUserRepository(cfg)
+	if err != nil {
+		log.Fatalf("init repository: %v", err)
+	}
+	defer closer.Close()
+
+	app := fiber.New(fiber.Config{ErrorHandler: handlers.ErrorHandler})
+	rt := router.New(app)
+	jwtSecret := []byte(cfg.JWTSecret)
+
+	app.Use(requestid.New())
+	app.Use(recover.New())
+	app.Use(logger.New(logger.Config{
+		Format: "${time} [${locals:requestid}] ${status} ${latency} ${method} ${path}\n",
+	}))
+	app.Use(cors.New(cors.Config{
+		AllowOrigins: strings.Split(cfg.CORSOrigins, ","),
+	}))
+	app.Use(middleware.MetricsMiddleware(rt))
+
+	rt.Get("/", func(c fiber.Ctx) error {
+		return c.JSON(fiber.Map{"message": "Hello world"})
+	}).Name("home")
+
+	rt.Post("/api/auth/register", auth.RegisterHandler(userRepository)).Name("auth.register")
+	rt.Post("/api/auth/login", auth.LoginHandler(userRepository, jwtSecret)).Name("auth.login")
+	rt.Post("/api/auth/refresh", auth.RefreshHandler(jwtSecret)).Name("auth.refresh")
+
+	rt.Get("/api/users", user.ListHandler(userRepository)).Name("users.list")
+	rt.Get("/api/users/:id", user.GetHandler(userRepository)).Name("users.show")
+	requireAuth := middleware.RequireAuth(userRepository, jwtSecret)
+	rt.Post("/api/users", requireAuth, user.CreateHandler(userRepository, rt)).Name("users.create")
+	rt.Patch("/api/users/:id", requireAuth, user.UpdateHandler(userRepository)).Name("users.update")
+	rt.Delete("/api/users/:id", requireAuth, user.DeleteHandler(userRepository)).Name("users.destroy")
+
+	rt.Get("/debug/routes", rt.DebugRoutesHandler).Name("debug.routes")
+	rt.Get("/metrics", middleware.MetricsHandler()).Name("metrics")
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- app.Listen(cfg.Addr())
+	}()
+
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			log.Fatalf("listen: %v", err)
+		}
+	case <-ctx.Done():
+		log.Println("shutting down gracefully")
+		if err := app.ShutdownWithTimeout(shutdownTimeout); err != nil {
+			log.Printf("graceful shutdown failed: %v", err)
+		}
+	}
+}
+
+// newUserRepository selects a UserRepository implementation based on
+// cfg.DBDriver so the same server binary can run against Postgres,
+// SQLite, or an in-memory store. It also returns an io.Closer that must
+// be closed on shutdown to release any underlying connection.
+func newUserRepository(cfg config.Config) (repository.UserRepository, io.Closer, error) {
+	switch cfg.DBDriver {
+	case "", "memory":
+		return repository.NewMemoryUserRepository(), noopCloser{}, nil
+	case "postgres", "sqlite":
+		db, err := sql.Open(cfg.DBDriver, cfg.DBDSN)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open %s db: %w", cfg.DBDriver, err)
+		}
+		if err := repository.Migrate(db); err != nil {
+			return nil, nil, fmt.Errorf("migrate %s db: %w", cfg.DBDriver, err)
+		}
+		return repository.NewSQLUserRepository(db), db, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown DB_DRIVER %q", cfg.DBDriver)
+	}
+}
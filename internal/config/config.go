@@ -0,0 +1,67 @@
+// Package config loads server configuration from an optional YAML file
+// with environment variables layered on top, so the same binary can be
+// configured per environment without a rebuild.
+package config
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every runtime-tunable setting for the server.
+type Config struct {
+	Host        string `yaml:"host"`
+	Port        string `yaml:"port"`
+	DBDriver    string `yaml:"dbDriver"`
+	DBDSN       string `yaml:"dbDsn"`
+	JWTSecret   string `yaml:"jwtSecret"`
+	LogLevel    string `yaml:"logLevel"`
+	CORSOrigins string `yaml:"corsOrigins"`
+}
+
+// Load builds a Config from CONFIG_FILE (if set) and then applies
+// environment variable overrides on top, falling back to development
+// defaults for anything left unset.
+func Load() (Config, error) {
+	cfg := Config{
+		Host:        "0.0.0.0",
+		Port:        "4000",
+		DBDriver:    "memory",
+		JWTSecret:   "dev-secret-change-me",
+		LogLevel:    "info",
+		CORSOrigins: "*",
+	}
+
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Config{}, err
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	cfg.Host = envOrDefault("HOST", cfg.Host)
+	cfg.Port = envOrDefault("PORT", cfg.Port)
+	cfg.DBDriver = envOrDefault("DB_DRIVER", cfg.DBDriver)
+	cfg.DBDSN = envOrDefault("DB_DSN", cfg.DBDSN)
+	cfg.JWTSecret = envOrDefault("JWT_SECRET", cfg.JWTSecret)
+	cfg.LogLevel = envOrDefault("LOG_LEVEL", cfg.LogLevel)
+	cfg.CORSOrigins = envOrDefault("CORS_ALLOWED_ORIGINS", cfg.CORSOrigins)
+
+	return cfg, nil
+}
+
+// Addr returns the host:port pair app.Listen expects.
+func (c Config) Addr() string {
+	return c.Host + ":" + c.Port
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,147 @@
+// Package auth holds the HTTP handlers for registration, login, and
+// token refresh.
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/rekasa7000/Logcha/internal/handlers"
+	"github.com/rekasa7000/Logcha/internal/repository"
+	"github.com/rekasa7000/Logcha/internal/token"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+)
+
+type registerRequest struct {
+	FirstName string `json:"firstName" validate:"required"`
+	Lastname  string `json:"lastName" validate:"required"`
+	UserName  string `json:"userName" validate:"required"`
+	Password  string `json:"password" validate:"required,min=8"`
+}
+
+type loginRequest struct {
+	UserName string `json:"userName" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type refreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type tokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RegisterHandler returns POST /api/auth/register.
+func RegisterHandler(users repository.UserRepository) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var req registerRequest
+		if err := c.Bind().Body(&req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+		if err := handlers.Validate.Struct(req); err != nil {
+			return err
+		}
+
+		if _, err := users.GetByUserName(req.UserName); err == nil {
+			return fiber.NewError(fiber.StatusConflict, "username already taken")
+		} else if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+
+		created, err := users.Create(repository.User{
+			FirstName:    req.FirstName,
+			Lastname:     req.Lastname,
+			UserName:     req.UserName,
+			PasswordHash: string(hash),
+			IsActive:     true,
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(created)
+	}
+}
+
+// LoginHandler returns POST /api/auth/login. On success it issues a
+// fresh access/refresh token pair signed with secret.
+func LoginHandler(users repository.UserRepository, secret []byte) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var req loginRequest
+		if err := c.Bind().Body(&req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+		if err := handlers.Validate.Struct(req); err != nil {
+			return err
+		}
+
+		user, err := users.GetByUserName(req.UserName)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return fiber.NewError(fiber.StatusUnauthorized, "invalid username or password")
+			}
+			return err
+		}
+
+		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid username or password")
+		}
+
+		pair, err := issueTokenPair(user.ID, secret)
+		if err != nil {
+			return err
+		}
+		return c.JSON(pair)
+	}
+}
+
+// RefreshHandler returns POST /api/auth/refresh, exchanging a valid,
+// unexpired refresh token for a new token pair signed with secret.
+func RefreshHandler(secret []byte) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var req refreshRequest
+		if err := c.Bind().Body(&req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+		if err := handlers.Validate.Struct(req); err != nil {
+			return err
+		}
+
+		claims, err := token.Parse(req.RefreshToken, secret)
+		if err != nil || claims.Type != token.RefreshToken {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid or expired refresh token")
+		}
+
+		pair, err := issueTokenPair(claims.UserID, secret)
+		if err != nil {
+			return err
+		}
+		return c.JSON(pair)
+	}
+}
+
+func issueTokenPair(userID int, secret []byte) (tokenPair, error) {
+	access, err := token.Sign(userID, token.AccessToken, accessTokenTTL, secret)
+	if err != nil {
+		return tokenPair{}, err
+	}
+	refresh, err := token.Sign(userID, token.RefreshToken, refreshTokenTTL, secret)
+	if err != nil {
+		return tokenPair{}, err
+	}
+	return tokenPair{AccessToken: access, RefreshToken: refresh}, nil
+}
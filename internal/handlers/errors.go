@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	"github.com/rekasa7000/Logcha/internal/repository"
+)
+
+// errorEnvelope is the consistent shape every error response is rendered
+// in, regardless of whether it came from a handler, a validation
+// failure, or a panic.
+type errorEnvelope struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details any    `json:"details,omitempty"`
+}
+
+// ErrorHandler centralizes status-code and body selection for every
+// error returned from a handler, so individual handlers can just
+// `return err` (or fiber.NewError(...)) instead of shaping a response.
+// It is installed as fiber.Config.ErrorHandler.
+func ErrorHandler(c fiber.Ctx, err error) error {
+	code := fiber.StatusInternalServerError
+	message := "internal server error"
+	var details any
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		code = fiberErr.Code
+		message = fiberErr.Message
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		code = fiber.StatusUnprocessableEntity
+		message = "validation failed"
+		details = formatValidationErrors(validationErrs)
+	}
+
+	if errors.Is(err, repository.ErrNotFound) {
+		code = fiber.StatusNotFound
+		message = err.Error()
+	}
+
+	if errors.Is(err, repository.ErrUserNameTaken) {
+		code = fiber.StatusConflict
+		message = err.Error()
+	}
+
+	return c.Status(code).JSON(errorEnvelope{Code: code, Message: message, Details: details})
+}
+
+// formatValidationErrors turns validator's field errors into a
+// field -> reason map suitable for the envelope's details.
+func formatValidationErrors(errs validator.ValidationErrors) map[string]string {
+	details := make(map[string]string, len(errs))
+	for _, fieldErr := range errs {
+		details[fieldErr.Field()] = "failed " + fieldErr.Tag()
+	}
+	return details
+}
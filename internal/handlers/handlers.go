@@ -0,0 +1,8 @@
+// Package handlers holds pieces shared across the handler subpackages:
+// the struct validator instance and the common error envelope.
+package handlers
+
+import "github.com/go-playground/validator/v10"
+
+// Validate is the struct validator shared by every handler subpackage.
+var Validate = validator.New()
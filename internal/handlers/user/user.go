@@ -0,0 +1,152 @@
+// Package user holds the HTTP handlers for the /api/users resource.
+package user
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/rekasa7000/Logcha/internal/handlers"
+	"github.com/rekasa7000/Logcha/internal/repository"
+	"github.com/rekasa7000/Logcha/internal/router"
+)
+
+const defaultListLimit = 20
+
+// listPage is the envelope returned by ListHandler, carrying the cursor
+// a client should pass back in to fetch the next page.
+type listPage struct {
+	Users      []repository.User `json:"users"`
+	NextCursor int               `json:"nextCursor,omitempty"`
+}
+
+// ListHandler returns GET /api/users, paginated via ?limit and ?cursor
+// (the last id seen on the previous page).
+func ListHandler(users repository.UserRepository) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		limit := defaultListLimit
+		if raw := c.Query("limit"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed <= 0 {
+				return fiber.NewError(fiber.StatusBadRequest, "limit must be a positive integer")
+			}
+			limit = parsed
+		}
+
+		cursor := 0
+		if raw := c.Query("cursor"); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil || parsed < 0 {
+				return fiber.NewError(fiber.StatusBadRequest, "cursor must be a non-negative integer")
+			}
+			cursor = parsed
+		}
+
+		all, err := users.List()
+		if err != nil {
+			return err
+		}
+
+		page := make([]repository.User, 0, limit)
+		nextCursor := 0
+		for _, user := range all {
+			if user.ID <= cursor {
+				continue
+			}
+			if len(page) == limit {
+				nextCursor = page[len(page)-1].ID
+				break
+			}
+			page = append(page, user)
+		}
+
+		return c.JSON(listPage{Users: page, NextCursor: nextCursor})
+	}
+}
+
+// GetHandler returns GET /api/users/:id.
+func GetHandler(users repository.UserRepository) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "id must be an integer")
+		}
+
+		user, err := users.Get(id)
+		if err != nil {
+			return err
+		}
+		return c.JSON(user)
+	}
+}
+
+// CreateHandler returns POST /api/users. On success it sets a Location
+// header pointing at the new user's canonical URL.
+func CreateHandler(users repository.UserRepository, rt *router.Router) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		user := repository.User{}
+		if err := c.Bind().Body(&user); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+
+		if err := handlers.Validate.Struct(user); err != nil {
+			return err
+		}
+
+		created, err := users.Create(user)
+		if err != nil {
+			return err
+		}
+
+		if location, err := rt.URL("users.show", fiber.Map{"id": created.ID}); err == nil {
+			c.Set(fiber.HeaderLocation, location)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"message": "User created successfully!", "user": created})
+	}
+}
+
+// UpdateHandler returns PATCH /api/users/:id.
+func UpdateHandler(users repository.UserRepository) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "id must be an integer")
+		}
+
+		existing, err := users.Get(id)
+		if err != nil {
+			return err
+		}
+
+		patched := existing
+		if err := c.Bind().Body(&patched); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+
+		if err := handlers.Validate.Struct(patched); err != nil {
+			return err
+		}
+
+		updated, err := users.Update(id, patched)
+		if err != nil {
+			return err
+		}
+		return c.JSON(updated)
+	}
+}
+
+// DeleteHandler returns DELETE /api/users/:id.
+func DeleteHandler(users repository.UserRepository) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "id must be an integer")
+		}
+
+		if err := users.Delete(id); err != nil {
+			return err
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
@@ -0,0 +1,40 @@
+// Package middleware holds this application's own fiber middleware
+// (auth enforcement and metrics), as opposed to the stock middleware
+// from gofiber/fiber/v3/middleware that cmd/server wires in directly.
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+
+	"github.com/rekasa7000/Logcha/internal/repository"
+	"github.com/rekasa7000/Logcha/internal/token"
+)
+
+// RequireAuth validates the bearer token on the request, loads the
+// corresponding user, and injects it into c.Locals("user") for
+// downstream handlers.
+func RequireAuth(users repository.UserRepository, secret []byte) fiber.Handler {
+	const bearerPrefix = "Bearer "
+
+	return func(c fiber.Ctx) error {
+		header := c.Get(fiber.HeaderAuthorization)
+		if !strings.HasPrefix(header, bearerPrefix) {
+			return fiber.NewError(fiber.StatusUnauthorized, "missing bearer token")
+		}
+
+		claims, err := token.Parse(strings.TrimPrefix(header, bearerPrefix), secret)
+		if err != nil || claims.Type != token.AccessToken {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid or expired token")
+		}
+
+		user, err := users.Get(claims.UserID)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, "invalid or expired token")
+		}
+
+		c.Locals("user", user)
+		return c.Next()
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/rekasa7000/Logcha/internal/router"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests processed, labeled by route, method, and status.",
+	}, []string{"route", "method", "status"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds, labeled by route and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	requestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "http_requests_in_flight",
+		Help: "Number of in-flight HTTP requests; the route label is always \"all\", not the matched route.",
+	}, []string{"route"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration, requestsInFlight)
+}
+
+// MetricsMiddleware records per-route request counts and latency, plus
+// an overall in-flight gauge. Routes are identified by the name they
+// were registered under so dashboards stay stable across path changes.
+func MetricsMiddleware(rt *router.Router) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		// c.Route() still reports this middleware's own mount point
+		// until after c.Next() runs the rest of the chain, so the
+		// matched route can't be labeled until dispatch has happened.
+		requestsInFlight.WithLabelValues("all").Inc()
+		defer requestsInFlight.WithLabelValues("all").Dec()
+
+		start := time.Now()
+		err := c.Next()
+
+		route := rt.RouteName(c)
+		if route == "" {
+			route = "unmatched"
+		}
+
+		status := strconv.Itoa(c.Response().StatusCode())
+		requestsTotal.WithLabelValues(route, c.Method(), status).Inc()
+		requestDuration.WithLabelValues(route, c.Method()).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// MetricsHandler exposes the registered collectors in the Prometheus
+// text format, intended to be mounted at GET /metrics.
+func MetricsHandler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.Handler())
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemoryUserRepository is an in-process UserRepository backed by a map.
+// It is safe for concurrent use and is intended for local development
+// and tests; state does not survive a restart.
+type MemoryUserRepository struct {
+	mu     sync.RWMutex
+	users  map[int]User
+	nextID int
+}
+
+// NewMemoryUserRepository returns an empty, ready-to-use in-memory repository.
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{
+		users:  make(map[int]User),
+		nextID: 1,
+	}
+}
+
+func (r *MemoryUserRepository) Create(user User) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.UserName == user.UserName {
+			return User{}, ErrUserNameTaken
+		}
+	}
+
+	user.ID = r.nextID
+	r.nextID++
+	r.users[user.ID] = user
+	return user, nil
+}
+
+func (r *MemoryUserRepository) Get(id int) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	return user, nil
+}
+
+func (r *MemoryUserRepository) GetByUserName(userName string) (User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.UserName == userName {
+			return u, nil
+		}
+	}
+	return User{}, ErrNotFound
+}
+
+func (r *MemoryUserRepository) List() ([]User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]User, 0, len(r.users))
+	for _, u := range r.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users, nil
+}
+
+func (r *MemoryUserRepository) Update(id int, user User) (User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return User{}, ErrNotFound
+	}
+
+	for otherID, existing := range r.users {
+		if otherID != id && existing.UserName == user.UserName {
+			return User{}, ErrUserNameTaken
+		}
+	}
+
+	user.ID = id
+	r.users[id] = user
+	return user, nil
+}
+
+func (r *MemoryUserRepository) Delete(id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
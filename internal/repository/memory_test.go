@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestMemoryUserRepository_CreateRejectsDuplicateUserName(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	if _, err := repo.Create(User{UserName: "alice"}); err != nil {
+		t.Fatalf("first Create failed: %v", err)
+	}
+
+	if _, err := repo.Create(User{UserName: "alice"}); !errors.Is(err, ErrUserNameTaken) {
+		t.Fatalf("got err %v, want ErrUserNameTaken", err)
+	}
+}
+
+func TestMemoryUserRepository_UpdateRejectsDuplicateUserName(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	alice, err := repo.Create(User{UserName: "alice"})
+	if err != nil {
+		t.Fatalf("Create alice failed: %v", err)
+	}
+	bob, err := repo.Create(User{UserName: "bob"})
+	if err != nil {
+		t.Fatalf("Create bob failed: %v", err)
+	}
+
+	if _, err := repo.Update(bob.ID, User{UserName: alice.UserName}); !errors.Is(err, ErrUserNameTaken) {
+		t.Fatalf("got err %v, want ErrUserNameTaken", err)
+	}
+
+	users, err := repo.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	names := make(map[string]int, len(users))
+	for _, u := range users {
+		names[u.UserName]++
+	}
+	if names["alice"] != 1 {
+		t.Fatalf("got %d users named alice, want 1", names["alice"])
+	}
+}
+
+func TestMemoryUserRepository_UpdateAllowsUnchangedUserName(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	alice, err := repo.Create(User{UserName: "alice"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if _, err := repo.Update(alice.ID, User{UserName: "alice", Description: "updated"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+}
+
+func TestMemoryUserRepository_ConcurrentUpdateSameUserName(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	alice, err := repo.Create(User{UserName: "alice"})
+	if err != nil {
+		t.Fatalf("Create alice failed: %v", err)
+	}
+	bob, err := repo.Create(User{UserName: "bob"})
+	if err != nil {
+		t.Fatalf("Create bob failed: %v", err)
+	}
+
+	const attempts = 50
+	var wg sync.WaitGroup
+	successes := make(chan User, attempts)
+
+	wg.Add(attempts)
+	for i := 0; i < attempts; i++ {
+		go func() {
+			defer wg.Done()
+			if updated, err := repo.Update(bob.ID, User{UserName: alice.UserName}); err == nil {
+				successes <- updated
+			}
+		}()
+	}
+	wg.Wait()
+	close(successes)
+
+	count := 0
+	for range successes {
+		count++
+	}
+	if count != 0 {
+		t.Fatalf("got %d successful renames to a taken username, want 0", count)
+	}
+}
+
+func TestMemoryUserRepository_ConcurrentCreateSameUserName(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	const attempts = 50
+	var wg sync.WaitGroup
+	successes := make(chan User, attempts)
+
+	wg.Add(attempts)
+	for i := 0; i < attempts; i++ {
+		go func() {
+			defer wg.Done()
+			if created, err := repo.Create(User{UserName: "racer"}); err == nil {
+				successes <- created
+			}
+		}()
+	}
+	wg.Wait()
+	close(successes)
+
+	count := 0
+	for range successes {
+		count++
+	}
+	if count != 1 {
+		t.Fatalf("got %d successful creates for the same username, want 1", count)
+	}
+
+	users, err := repo.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(users) != 1 {
+		t.Fatalf("got %d stored users, want 1", len(users))
+	}
+}
+
+func TestMemoryUserRepository_ConcurrentCreateAndGet(t *testing.T) {
+	repo := NewMemoryUserRepository()
+
+	const workers = 50
+	var wg sync.WaitGroup
+	wg.Add(workers * 2)
+
+	for i := 0; i < workers; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			name := string(rune('a'+i%26)) + "-user"
+			if _, err := repo.Create(User{UserName: name}); err != nil && !errors.Is(err, ErrUserNameTaken) {
+				t.Errorf("Create failed: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// id may or may not exist yet depending on scheduling; either
+			// outcome is valid, the race detector is what matters here.
+			if _, err := repo.Get(i + 1); err != nil && !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// usersTableDDL creates the users table if it does not already exist.
+// The id column type is filled in by Migrate: INTEGER on SQLite, where an
+// INTEGER PRIMARY KEY aliases the rowid and is assigned on insert, and
+// SERIAL on Postgres, so both assign ids for SQLUserRepository.Create.
+const usersTableDDL = `
+CREATE TABLE IF NOT EXISTS users (
+	id            %s PRIMARY KEY,
+	first_name    TEXT NOT NULL DEFAULT '',
+	last_name     TEXT NOT NULL DEFAULT '',
+	user_name     TEXT NOT NULL UNIQUE,
+	description   TEXT NOT NULL DEFAULT '',
+	is_active     BOOLEAN NOT NULL DEFAULT true,
+	password_hash TEXT NOT NULL DEFAULT ''
+);
+`
+
+// Migrate applies the schema required by SQLUserRepository. It is safe
+// to call on every startup.
+func Migrate(db *sql.DB) error {
+	idType := "INTEGER"
+	if _, ok := db.Driver().(*pq.Driver); ok {
+		idType = "SERIAL"
+	}
+	_, err := db.Exec(fmt.Sprintf(usersTableDDL, idType))
+	return err
+}
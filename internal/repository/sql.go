@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+)
+
+// SQLUserRepository is a UserRepository backed by database/sql. It works
+// against any driver that speaks standard SQL placeholders for the simple
+// CRUD statements below (Postgres and SQLite are both exercised in practice).
+type SQLUserRepository struct {
+	db *sql.DB
+}
+
+// NewSQLUserRepository wraps an already-opened *sql.DB. Callers own the
+// connection lifecycle and are responsible for running migrations first.
+func NewSQLUserRepository(db *sql.DB) *SQLUserRepository {
+	return &SQLUserRepository{db: db}
+}
+
+func (r *SQLUserRepository) Create(user User) (User, error) {
+	err := r.db.QueryRow(
+		`INSERT INTO users (first_name, last_name, user_name, description, is_active, password_hash)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id`,
+		user.FirstName, user.Lastname, user.UserName, user.Description, user.IsActive, user.PasswordHash,
+	).Scan(&user.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return User{}, ErrUserNameTaken
+		}
+		return User{}, err
+	}
+	return user, nil
+}
+
+func (r *SQLUserRepository) Get(id int) (User, error) {
+	var user User
+	err := r.db.QueryRow(
+		`SELECT id, first_name, last_name, user_name, description, is_active, password_hash
+		 FROM users WHERE id = $1`,
+		id,
+	).Scan(&user.ID, &user.FirstName, &user.Lastname, &user.UserName, &user.Description, &user.IsActive, &user.PasswordHash)
+	if err == sql.ErrNoRows {
+		return User{}, ErrNotFound
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+func (r *SQLUserRepository) GetByUserName(userName string) (User, error) {
+	var user User
+	err := r.db.QueryRow(
+		`SELECT id, first_name, last_name, user_name, description, is_active, password_hash
+		 FROM users WHERE user_name = $1`,
+		userName,
+	).Scan(&user.ID, &user.FirstName, &user.Lastname, &user.UserName, &user.Description, &user.IsActive, &user.PasswordHash)
+	if err == sql.ErrNoRows {
+		return User{}, ErrNotFound
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+func (r *SQLUserRepository) List() ([]User, error) {
+	rows, err := r.db.Query(
+		`SELECT id, first_name, last_name, user_name, description, is_active, password_hash
+		 FROM users ORDER BY id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []User{}
+	for rows.Next() {
+		var user User
+		if err := rows.Scan(&user.ID, &user.FirstName, &user.Lastname, &user.UserName, &user.Description, &user.IsActive, &user.PasswordHash); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *SQLUserRepository) Update(id int, user User) (User, error) {
+	result, err := r.db.Exec(
+		`UPDATE users SET first_name = $1, last_name = $2, user_name = $3, description = $4, is_active = $5, password_hash = $6
+		 WHERE id = $7`,
+		user.FirstName, user.Lastname, user.UserName, user.Description, user.IsActive, user.PasswordHash, id,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return User{}, ErrUserNameTaken
+		}
+		return User{}, err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return User{}, err
+	} else if n == 0 {
+		return User{}, ErrNotFound
+	}
+	user.ID = id
+	return user, nil
+}
+
+func (r *SQLUserRepository) Delete(id int) error {
+	result, err := r.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// isUniqueViolation reports whether err is the driver's representation of
+// a UNIQUE constraint violation, across both of the drivers this
+// repository is exercised against (pq's SQLSTATE 23505 for Postgres,
+// modernc.org/sqlite's SQLITE_CONSTRAINT_UNIQUE for SQLite).
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Name() == "unique_violation"
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == 2067 // SQLITE_CONSTRAINT_UNIQUE
+	}
+
+	return false
+}
@@ -0,0 +1,32 @@
+package repository
+
+import "errors"
+
+// ErrNotFound is returned when a lookup does not match any stored user.
+var ErrNotFound = errors.New("user not found")
+
+// ErrUserNameTaken is returned by Create when userName is already in use.
+var ErrUserNameTaken = errors.New("username already taken")
+
+// User is the persisted representation of an application user.
+type User struct {
+	ID           int    `json:"id"`
+	FirstName    string `json:"firstName" validate:"required"`
+	Lastname     string `json:"lastName" validate:"required"`
+	UserName     string `json:"userName" validate:"required"`
+	Description  string `json:"description"`
+	IsActive     bool   `json:"isActive"`
+	PasswordHash string `json:"-"`
+}
+
+// UserRepository abstracts user persistence so handlers can be wired
+// against an in-memory store during development and a SQL-backed store
+// in production without changing call sites.
+type UserRepository interface {
+	Create(user User) (User, error)
+	Get(id int) (User, error)
+	GetByUserName(userName string) (User, error)
+	List() ([]User, error)
+	Update(id int, user User) (User, error)
+	Delete(id int) error
+}
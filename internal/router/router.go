@@ -0,0 +1,118 @@
+// Package router wraps fiber.App with named routes and reverse URL
+// generation, mirroring the route-naming feature from upstream Fiber
+// so handlers can link to each other without hardcoding paths.
+package router
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RouteInfo describes a named route registered through Router.
+type RouteInfo struct {
+	Name   string `json:"name"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// Router records route names alongside the fiber.App they were
+// registered on, so a name can later be resolved back to a path.
+type Router struct {
+	app    *fiber.App
+	routes map[string]RouteInfo
+	byKey  map[string]string
+}
+
+// New wraps app in a Router with an empty route registry.
+func New(app *fiber.App) *Router {
+	return &Router{
+		app:    app,
+		routes: make(map[string]RouteInfo),
+		byKey:  make(map[string]string),
+	}
+}
+
+// Registration is returned by Router's HTTP method helpers so the route
+// can be named inline, e.g. router.Get(path, handler).Name("users.show").
+type Registration struct {
+	router *Router
+	method string
+	path   string
+}
+
+// Name records name as the canonical name for this route and returns the
+// registration for chaining.
+func (reg *Registration) Name(name string) *Registration {
+	reg.router.routes[name] = RouteInfo{Name: name, Method: reg.method, Path: reg.path}
+	reg.router.byKey[reg.method+" "+reg.path] = name
+	return reg
+}
+
+func (r *Router) register(method, path string, handlers ...fiber.Handler) *Registration {
+	extra := make([]any, len(handlers)-1)
+	for i, h := range handlers[1:] {
+		extra[i] = h
+	}
+	r.app.Add([]string{method}, path, handlers[0], extra...)
+	return &Registration{router: r, method: method, path: path}
+}
+
+func (r *Router) Get(path string, handlers ...fiber.Handler) *Registration {
+	return r.register(fiber.MethodGet, path, handlers...)
+}
+
+func (r *Router) Post(path string, handlers ...fiber.Handler) *Registration {
+	return r.register(fiber.MethodPost, path, handlers...)
+}
+
+func (r *Router) Patch(path string, handlers ...fiber.Handler) *Registration {
+	return r.register(fiber.MethodPatch, path, handlers...)
+}
+
+func (r *Router) Delete(path string, handlers ...fiber.Handler) *Registration {
+	return r.register(fiber.MethodDelete, path, handlers...)
+}
+
+// GetRoute returns the RouteInfo registered under name, if any.
+func (r *Router) GetRoute(name string) (RouteInfo, bool) {
+	info, ok := r.routes[name]
+	return info, ok
+}
+
+// URL builds the path for the named route, substituting each :param
+// segment with the matching entry from params. It returns an error if
+// the route is unknown.
+func (r *Router) URL(name string, params fiber.Map) (string, error) {
+	info, ok := r.routes[name]
+	if !ok {
+		return "", fmt.Errorf("router: no route named %q", name)
+	}
+
+	path := info.Path
+	for key, value := range params {
+		path = strings.ReplaceAll(path, ":"+key, fmt.Sprint(value))
+	}
+	return path, nil
+}
+
+// RouteName returns the registered name of the route that matched the
+// current request, or "" if the matched route was never named.
+func (r *Router) RouteName(c fiber.Ctx) string {
+	route := c.Route()
+	if route == nil {
+		return ""
+	}
+	return r.byKey[c.Method()+" "+route.Path]
+}
+
+// DebugRoutesHandler renders the full named-route table as JSON. It is
+// intended to be mounted at GET /debug/routes.
+func (r *Router) DebugRoutesHandler(c fiber.Ctx) error {
+	routes := make([]RouteInfo, 0, len(r.routes))
+	for _, info := range r.routes {
+		routes = append(routes, info)
+	}
+	return c.JSON(fiber.Map{"routes": routes})
+}
@@ -0,0 +1,59 @@
+// Package token signs and verifies the JWTs used for authentication,
+// shared between the auth handlers that issue them and the middleware
+// that verifies them.
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Type distinguishes the short-lived access token from the long-lived
+// refresh token so one can't be used in place of the other.
+type Type string
+
+const (
+	AccessToken  Type = "access"
+	RefreshToken Type = "refresh"
+)
+
+// Claims is the JWT payload for both access and refresh tokens; Type
+// records which one this is so callers can reject a token used outside
+// its intended purpose.
+type Claims struct {
+	UserID int  `json:"userId"`
+	Type   Type `json:"type"`
+	jwt.RegisteredClaims
+}
+
+// Sign produces a tokenType token for userID, signed with secret, that
+// expires after ttl.
+func Sign(userID int, tokenType Type, ttl time.Duration, secret []byte) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		Type:   tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
+
+// Parse validates raw against secret and returns its claims.
+func Parse(raw string, secret []byte) (*Claims, error) {
+	claims := &Claims{}
+	parsed, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		return secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !parsed.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+	return claims, nil
+}